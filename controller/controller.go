@@ -17,12 +17,15 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/kubernetes-incubator/external-dns/endpoint"
 	"github.com/kubernetes-incubator/external-dns/plan"
+	"github.com/kubernetes-incubator/external-dns/provider"
 	"github.com/kubernetes-incubator/external-dns/registry"
 	"github.com/kubernetes-incubator/external-dns/source"
 )
@@ -56,6 +59,48 @@ var (
 			Help:      "Number of Endpoints in the registry",
 		},
 	)
+	registryRecordsByType = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "external_dns",
+			Subsystem: "registry",
+			Name:      "records",
+			Help:      "Number of records in the registry by record type",
+		},
+		[]string{"record_type"},
+	)
+	appliedCreatesTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "external_dns",
+			Subsystem: "controller",
+			Name:      "applied_creates_total",
+			Help:      "Number of record creations applied to the registry",
+		},
+	)
+	appliedUpdatesTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "external_dns",
+			Subsystem: "controller",
+			Name:      "applied_updates_total",
+			Help:      "Number of record updates applied to the registry",
+		},
+	)
+	appliedDeletesTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "external_dns",
+			Subsystem: "controller",
+			Name:      "applied_deletes_total",
+			Help:      "Number of record deletions applied to the registry",
+		},
+	)
+	applyBatchSize = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "external_dns",
+			Subsystem: "controller",
+			Name:      "apply_batch_size",
+			Help:      "Number of changes applied to the registry per synchronization that changed records",
+			Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+		},
+	)
 )
 
 func init() {
@@ -63,6 +108,11 @@ func init() {
 	prometheus.MustRegister(sourceErrors)
 	prometheus.MustRegister(sourceEndpointsTotal)
 	prometheus.MustRegister(registryEndpointsTotal)
+	prometheus.MustRegister(registryRecordsByType)
+	prometheus.MustRegister(appliedCreatesTotal)
+	prometheus.MustRegister(appliedUpdatesTotal)
+	prometheus.MustRegister(appliedDeletesTotal)
+	prometheus.MustRegister(applyBatchSize)
 }
 
 // Controller is responsible for orchestrating the different components.
@@ -78,6 +128,49 @@ type Controller struct {
 	Policy plan.Policy
 	// The interval between individual synchronizations
 	Interval time.Duration
+	// The domains whose records are managed, records outside of it are left untouched
+	DomainFilter provider.DomainFilter
+	// The maximum fraction of the current records a single synchronization may delete, 0 disables the check
+	MaxDeleteFraction float64
+	// Whether to apply deletions even if they exceed MaxDeleteFraction
+	ForceDelete bool
+	// Whether the registry only prints changes, in which case they are not counted as applied
+	DryRun bool
+}
+
+// filterEndpoints returns the endpoints whose DNS name is matched by the given domain filter
+func filterEndpoints(endpoints []*endpoint.Endpoint, domainFilter provider.DomainFilter) []*endpoint.Endpoint {
+	filtered := []*endpoint.Endpoint{}
+	for _, ep := range endpoints {
+		if !domainFilter.Match(ep.DNSName) {
+			log.Debugf("Skipping endpoint %s because it does not match the domain filter", ep.DNSName)
+			continue
+		}
+		filtered = append(filtered, ep)
+	}
+	return filtered
+}
+
+// checkDeleteFraction returns an error if deleting the given number of records exceeds the allowed fraction of the current records
+func (c *Controller) checkDeleteFraction(deletes, current int) error {
+	if c.ForceDelete || c.MaxDeleteFraction <= 0 || deletes == 0 {
+		return nil
+	}
+	if float64(deletes) > c.MaxDeleteFraction*float64(current) {
+		return fmt.Errorf("refusing to delete %d of %d records, which exceeds the maximum delete fraction of %v", deletes, current, c.MaxDeleteFraction)
+	}
+	return nil
+}
+
+// countAppliedChanges records the composition of the applied changes, skipping the batch size of empty batches
+func countAppliedChanges(changes *plan.Changes) {
+	creates, updates, deletes := len(changes.Create), len(changes.UpdateNew), len(changes.Delete)
+	appliedCreatesTotal.Add(float64(creates))
+	appliedUpdatesTotal.Add(float64(updates))
+	appliedDeletesTotal.Add(float64(deletes))
+	if batch := creates + updates + deletes; batch > 0 {
+		applyBatchSize.Observe(float64(batch))
+	}
 }
 
 // RunOnce runs a single iteration of a reconciliation loop.
@@ -89,6 +182,12 @@ func (c *Controller) RunOnce() error {
 	}
 	registryEndpointsTotal.Set(float64(len(records)))
 
+	// reset first so that record types which disappeared from the registry are no longer reported
+	registryRecordsByType.Reset()
+	for _, r := range records {
+		registryRecordsByType.WithLabelValues(r.RecordType).Inc()
+	}
+
 	endpoints, err := c.Source.Endpoints()
 	if err != nil {
 		sourceErrors.Inc()
@@ -98,17 +197,26 @@ func (c *Controller) RunOnce() error {
 
 	plan := &plan.Plan{
 		Policies: []plan.Policy{c.Policy},
-		Current:  records,
-		Desired:  endpoints,
+		Current:  filterEndpoints(records, c.DomainFilter),
+		Desired:  filterEndpoints(endpoints, c.DomainFilter),
 	}
 
 	plan = plan.Calculate()
 
+	if err := c.checkDeleteFraction(len(plan.Changes.Delete), len(plan.Current)); err != nil {
+		return err
+	}
+
 	err = c.Registry.ApplyChanges(plan.Changes)
 	if err != nil {
 		registryErrors.Inc()
 		return err
 	}
+
+	if !c.DryRun {
+		countAppliedChanges(plan.Changes)
+	}
+
 	return nil
 }
 
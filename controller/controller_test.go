@@ -18,6 +18,7 @@ package controller
 
 import (
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/kubernetes-incubator/external-dns/endpoint"
@@ -26,6 +27,8 @@ import (
 	"github.com/kubernetes-incubator/external-dns/provider"
 	"github.com/kubernetes-incubator/external-dns/registry"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -141,8 +144,211 @@ func TestRunOnce(t *testing.T) {
 		Policy:   &plan.SyncPolicy{},
 	}
 
+	creates, updates, deletes := testutil.ToFloat64(appliedCreatesTotal), testutil.ToFloat64(appliedUpdatesTotal), testutil.ToFloat64(appliedDeletesTotal)
+	batches, changes := histogramCountAndSum(t)
+
 	assert.NoError(t, ctrl.RunOnce())
 
+	// Validate that the applied changes were counted.
+	assert.Equal(t, creates+1, testutil.ToFloat64(appliedCreatesTotal))
+	assert.Equal(t, updates+1, testutil.ToFloat64(appliedUpdatesTotal))
+	assert.Equal(t, deletes+1, testutil.ToFloat64(appliedDeletesTotal))
+	newBatches, newChanges := histogramCountAndSum(t)
+	assert.Equal(t, batches+1, newBatches)
+	assert.Equal(t, changes+3, newChanges)
+
 	// Validate that the mock source was called.
 	source.AssertExpectations(t)
+
+	// Validate that the registry records were counted by type.
+	assert.Equal(t, float64(2), testutil.ToFloat64(registryRecordsByType.WithLabelValues(endpoint.RecordTypeA)))
+}
+
+// TestRunOnceDomainFilterExclusions tests that records under an excluded domain are neither created, updated nor deleted.
+func TestRunOnceDomainFilterExclusions(t *testing.T) {
+	source := new(testutils.MockSource)
+	source.On("Endpoints").Return([]*endpoint.Endpoint{
+		{DNSName: "create.example.org", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+		{DNSName: "create.api.example.org", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+		{DNSName: "update.api.example.org", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"8.8.4.4"}},
+	}, nil)
+
+	dnsProvider := newMockProvider(
+		[]*endpoint.Endpoint{
+			{DNSName: "update.api.example.org", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"8.8.8.8"}},
+			{DNSName: "delete.api.example.org", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"4.3.2.1"}},
+		},
+		&plan.Changes{
+			Create: []*endpoint.Endpoint{
+				{DNSName: "create.example.org", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+			},
+		},
+	)
+
+	r, err := registry.NewNoopRegistry(dnsProvider)
+	require.NoError(t, err)
+
+	ctrl := &Controller{
+		Source:       source,
+		Registry:     r,
+		Policy:       &plan.SyncPolicy{},
+		DomainFilter: provider.NewDomainFilterWithExclusions([]string{"example.org"}, []string{"api.example.org"}),
+	}
+
+	creates, updates, deletes := testutil.ToFloat64(appliedCreatesTotal), testutil.ToFloat64(appliedUpdatesTotal), testutil.ToFloat64(appliedDeletesTotal)
+
+	assert.NoError(t, ctrl.RunOnce())
+
+	// Only the record outside of the excluded domain was touched.
+	assert.Equal(t, creates+1, testutil.ToFloat64(appliedCreatesTotal))
+	assert.Equal(t, updates, testutil.ToFloat64(appliedUpdatesTotal))
+	assert.Equal(t, deletes, testutil.ToFloat64(appliedDeletesTotal))
+}
+
+// TestRunOnceMaxDeleteFraction tests that a synchronization deleting too many records is refused unless forced.
+func TestRunOnceMaxDeleteFraction(t *testing.T) {
+	records := []*endpoint.Endpoint{
+		{DNSName: "first-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+		{DNSName: "second-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"4.3.2.1"}},
+		{DNSName: "third-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"8.8.8.8"}},
+	}
+
+	for _, tc := range []struct {
+		title         string
+		forceDelete   bool
+		expectError   bool
+		expectDeletes float64
+	}{
+		{title: "delete-all is refused under the default threshold", forceDelete: false, expectError: true, expectDeletes: 0},
+		{title: "delete-all is applied when forced", forceDelete: true, expectError: false, expectDeletes: 3},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			// The source momentarily returns nothing while the registry is populated.
+			source := new(testutils.MockSource)
+			source.On("Endpoints").Return([]*endpoint.Endpoint{}, nil)
+
+			r, err := registry.NewNoopRegistry(newMockProvider(records, &plan.Changes{Delete: records}))
+			require.NoError(t, err)
+
+			ctrl := &Controller{
+				Source:            source,
+				Registry:          r,
+				Policy:            &plan.SyncPolicy{},
+				MaxDeleteFraction: 0.5,
+				ForceDelete:       tc.forceDelete,
+			}
+
+			deletes := testutil.ToFloat64(appliedDeletesTotal)
+
+			if tc.expectError {
+				assert.Error(t, ctrl.RunOnce())
+			} else {
+				assert.NoError(t, ctrl.RunOnce())
+			}
+
+			// Deletions are only counted once they were handed to the registry.
+			assert.Equal(t, deletes+tc.expectDeletes, testutil.ToFloat64(appliedDeletesTotal))
+		})
+	}
+}
+
+// TestRunOnceDryRun tests that changes are not counted as applied in dry-run mode.
+func TestRunOnceDryRun(t *testing.T) {
+	source := new(testutils.MockSource)
+	source.On("Endpoints").Return([]*endpoint.Endpoint{
+		{DNSName: "create-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+	}, nil)
+
+	r, err := registry.NewNoopRegistry(newMockProvider(
+		[]*endpoint.Endpoint{
+			{DNSName: "delete-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"4.3.2.1"}},
+		},
+		&plan.Changes{
+			Create: []*endpoint.Endpoint{
+				{DNSName: "create-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+			},
+			Delete: []*endpoint.Endpoint{
+				{DNSName: "delete-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"4.3.2.1"}},
+			},
+		},
+	))
+	require.NoError(t, err)
+
+	ctrl := &Controller{
+		Source:   source,
+		Registry: r,
+		Policy:   &plan.SyncPolicy{},
+		DryRun:   true,
+	}
+
+	creates, deletes := testutil.ToFloat64(appliedCreatesTotal), testutil.ToFloat64(appliedDeletesTotal)
+	batches, _ := histogramCountAndSum(t)
+
+	assert.NoError(t, ctrl.RunOnce())
+
+	assert.Equal(t, creates, testutil.ToFloat64(appliedCreatesTotal))
+	assert.Equal(t, deletes, testutil.ToFloat64(appliedDeletesTotal))
+	newBatches, _ := histogramCountAndSum(t)
+	assert.Equal(t, batches, newBatches)
+}
+
+// TestRunOnceRecordsByType tests that record types which disappear from the registry are no longer reported.
+func TestRunOnceRecordsByType(t *testing.T) {
+	for _, tc := range []struct {
+		records  []*endpoint.Endpoint
+		expected string
+	}{
+		{
+			records: []*endpoint.Endpoint{
+				{DNSName: "a-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+				{DNSName: "other-a-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"4.3.2.1"}},
+				{DNSName: "cname-record", RecordType: endpoint.RecordTypeCNAME, Targets: endpoint.Targets{"foo.example.org"}},
+			},
+			expected: `
+# HELP external_dns_registry_records Number of records in the registry by record type
+# TYPE external_dns_registry_records gauge
+external_dns_registry_records{record_type="A"} 2
+external_dns_registry_records{record_type="CNAME"} 1
+`,
+		},
+		{
+			records: []*endpoint.Endpoint{
+				{DNSName: "a-record", RecordType: endpoint.RecordTypeA, Targets: endpoint.Targets{"1.2.3.4"}},
+			},
+			expected: `
+# HELP external_dns_registry_records Number of records in the registry by record type
+# TYPE external_dns_registry_records gauge
+external_dns_registry_records{record_type="A"} 1
+`,
+		},
+	} {
+		// The source desires exactly the existing records, so no changes are applied.
+		source := new(testutils.MockSource)
+		source.On("Endpoints").Return(tc.records, nil)
+
+		r, err := registry.NewNoopRegistry(newMockProvider(tc.records, &plan.Changes{}))
+		require.NoError(t, err)
+
+		ctrl := &Controller{
+			Source:   source,
+			Registry: r,
+			Policy:   &plan.SyncPolicy{},
+		}
+
+		batches, _ := histogramCountAndSum(t)
+
+		require.NoError(t, ctrl.RunOnce())
+		assert.NoError(t, testutil.CollectAndCompare(registryRecordsByType, strings.NewReader(tc.expected)))
+
+		// Synchronizations without changes are not observed as batches.
+		newBatches, _ := histogramCountAndSum(t)
+		assert.Equal(t, batches, newBatches)
+	}
+}
+
+// histogramCountAndSum returns the number of observations and their sum recorded by the apply batch size histogram.
+func histogramCountAndSum(t *testing.T) (uint64, float64) {
+	m := &dto.Metric{}
+	require.NoError(t, applyBatchSize.Write(m))
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
 }
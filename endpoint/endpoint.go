@@ -18,6 +18,7 @@ package endpoint
 
 import (
 	"fmt"
+	"net"
 	"sort"
 	"strings"
 
@@ -27,6 +28,8 @@ import (
 const (
 	// RecordTypeA is a RecordType enum value
 	RecordTypeA = "A"
+	// RecordTypeAAAA is a RecordType enum value
+	RecordTypeAAAA = "AAAA"
 	// RecordTypeCNAME is a RecordType enum value
 	RecordTypeCNAME = "CNAME"
 	// RecordTypeTXT is a RecordType enum value
@@ -35,6 +38,32 @@ const (
 	RecordTypeSRV = "SRV"
 )
 
+// RedactedTarget replaces the values of TXT records in logs when RedactTXT is set
+const RedactedTarget = "<redacted>"
+
+// RedactTXT makes String and RedactTarget hide the values of TXT records, which can hold secrets such as ACME tokens
+var RedactTXT = false
+
+// RedactTarget returns the target of a record of the given type as it may be logged
+func RedactTarget(recordType, target string) string {
+	if !RedactTXT || recordType != RecordTypeTXT || target == "" {
+		return target
+	}
+	return RedactedTarget
+}
+
+// RedactTargets returns the targets of a record of the given type as they may be logged, without modifying them
+func RedactTargets(recordType string, targets []string) []string {
+	if !RedactTXT || recordType != RecordTypeTXT {
+		return targets
+	}
+	redacted := make([]string, len(targets))
+	for i, target := range targets {
+		redacted[i] = RedactTarget(recordType, target)
+	}
+	return redacted
+}
+
 // TTL is a structure defining the TTL of a DNS record
 type TTL int64
 
@@ -91,22 +120,68 @@ func (t Targets) Same(o Targets) bool {
 // FIXME We really need to define under which circumstances a list Targets is considered 'less'
 // than another.
 func (t Targets) IsLess(o Targets) bool {
-	if len(t) < len(o) {
-		return true
+	return CompareTargets(t, o) < 0
+}
+
+// CompareTargets returns an integer comparing two lists of targets regardless of the order of their entries.
+// Shorter lists sort first, lists of the same length are ordered by lexically comparing their sorted targets
+// entry by entry. The result is 0 if a and b contain the same targets, -1 if a sorts before b and +1 otherwise.
+// Neither of the passed lists is reordered.
+func CompareTargets(a, b Targets) int {
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
 	}
-	if len(t) > len(o) {
-		return false
+
+	a, b = a.Canonical(), b.Canonical()
+	for i, e := range a {
+		if e != b[i] {
+			if e < b[i] {
+				return -1
+			}
+			return 1
+		}
 	}
+	return 0
+}
 
-	sort.Sort(t)
-	sort.Sort(o)
+// Canonical returns a sorted copy of the targets, so that lists which only differ in ordering are identical.
+func (t Targets) Canonical() Targets {
+	canonical := NewTargets(t...)
+	sort.Sort(canonical)
+	return canonical
+}
 
-	for i, e := range t {
-		if e != o[i] {
-			return e < o[i]
+// Filter returns the targets for which keep returns true, in their original order.
+func (t Targets) Filter(keep func(string) bool) Targets {
+	filtered := Targets{}
+	for _, target := range t {
+		if keep(target) {
+			filtered = append(filtered, target)
 		}
 	}
-	return false
+	return filtered
+}
+
+// ValidIPs returns the targets which are valid IP literals for the given record type, IPv4 literals for
+// RecordTypeA and IPv6 literals for RecordTypeAAAA. IPv4-mapped IPv6 literals are no valid A targets.
+// No targets are returned for any other record type.
+func (t Targets) ValidIPs(recordType string) Targets {
+	return t.Filter(func(target string) bool {
+		ip := net.ParseIP(target)
+		if ip == nil {
+			return false
+		}
+		switch recordType {
+		case RecordTypeA:
+			return ip.To4() != nil && !strings.Contains(target, ":")
+		case RecordTypeAAAA:
+			return strings.Contains(target, ":")
+		}
+		return false
+	})
 }
 
 // ProviderSpecificProperty holds the name and value of a configuration which is specific to individual DNS providers
@@ -118,6 +193,18 @@ type ProviderSpecificProperty struct {
 // ProviderSpecific holds configuration which is specific to individual DNS providers
 type ProviderSpecific []ProviderSpecificProperty
 
+// sorted returns a copy of the properties ordered by name and value
+func (p ProviderSpecific) sorted() ProviderSpecific {
+	s := append(ProviderSpecific{}, p...)
+	sort.Slice(s, func(i, j int) bool {
+		if s[i].Name != s[j].Name {
+			return s[i].Name < s[j].Name
+		}
+		return s[i].Value < s[j].Value
+	})
+	return s
+}
+
 // Endpoint is a high-level way of a connection between a service and an IP
 type Endpoint struct {
 	// The hostname of the DNS record
@@ -181,8 +268,98 @@ func (e *Endpoint) GetProviderSpecificProperty(key string) (ProviderSpecificProp
 	return ProviderSpecificProperty{}, false
 }
 
+// IsOwnedBy returns true if the owner label of the Endpoint matches the given owner id.
+// Endpoints without an owner label are not owned by anyone, not even by an empty owner id.
+func (e *Endpoint) IsOwnedBy(ownerID string) bool {
+	owner, ok := e.Labels[OwnerLabelKey]
+	return ok && owner == ownerID
+}
+
+// Key returns a normalized key identifying the record described by the Endpoint, suitable for indexing maps.
+// Endpoints which only differ in the case or trailing dot of their DNS name share the same key.
+func (e *Endpoint) Key() string {
+	return strings.ToLower(strings.TrimSuffix(e.DNSName, ".")) + "|" + strings.ToUpper(e.RecordType)
+}
+
+// Equal returns true if both Endpoints describe exactly the same record. DNS names are compared by their Key,
+// targets regardless of their order, and provider specific properties regardless of their order.
+func (e *Endpoint) Equal(o *Endpoint) bool {
+	if e == nil || o == nil {
+		return e == o
+	}
+	if e.Key() != o.Key() || e.RecordTTL != o.RecordTTL || CompareTargets(e.Targets, o.Targets) != 0 {
+		return false
+	}
+	if len(e.Labels) != len(o.Labels) {
+		return false
+	}
+	for k, v := range e.Labels {
+		if ov, ok := o.Labels[k]; !ok || ov != v {
+			return false
+		}
+	}
+	if len(e.ProviderSpecific) != len(o.ProviderSpecific) {
+		return false
+	}
+	ps, ops := e.ProviderSpecific.sorted(), o.ProviderSpecific.sorted()
+	for i := range ps {
+		if ps[i] != ops[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (e *Endpoint) String() string {
-	return fmt.Sprintf("%s %d IN %s %s %s", e.DNSName, e.RecordTTL, e.RecordType, e.Targets, e.ProviderSpecific)
+	return fmt.Sprintf("%s %d IN %s %s %s", e.DNSName, e.RecordTTL, e.RecordType, Targets(RedactTargets(e.RecordType, e.Targets)), e.ProviderSpecific)
+}
+
+// MergeEndpoints merges incoming into existing, combining endpoints that share the same DNS name and record type.
+// Targets of combined endpoints are unioned, while the labels and TTL of the incoming endpoint take precedence.
+// The order of first appearance is kept and neither of the passed lists is modified.
+func MergeEndpoints(existing, incoming []*Endpoint) []*Endpoint {
+	merged := []*Endpoint{}
+	index := map[string]*Endpoint{}
+
+	for _, ep := range append(append([]*Endpoint{}, existing...), incoming...) {
+		key := ep.Key()
+		current, ok := index[key]
+		if !ok {
+			current = &Endpoint{
+				DNSName:    ep.DNSName,
+				Targets:    NewTargets(),
+				RecordType: ep.RecordType,
+				RecordTTL:  ep.RecordTTL,
+				Labels:     NewLabels(),
+			}
+			index[key] = current
+			merged = append(merged, current)
+		}
+
+		for _, target := range ep.Targets {
+			found := false
+			for _, t := range current.Targets {
+				if t == target {
+					found = true
+					break
+				}
+			}
+			if !found {
+				current.Targets = append(current.Targets, target)
+			}
+		}
+		for k, v := range ep.Labels {
+			current.Labels[k] = v
+		}
+		if ep.RecordTTL.IsConfigured() {
+			current.RecordTTL = ep.RecordTTL
+		}
+		if len(ep.ProviderSpecific) > 0 {
+			current.ProviderSpecific = append(ProviderSpecific{}, ep.ProviderSpecific...)
+		}
+	}
+
+	return merged
 }
 
 // DNSEndpointSpec defines the desired state of DNSEndpoint
@@ -17,6 +17,7 @@ limitations under the License.
 package endpoint
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -75,3 +76,240 @@ func TestSameFailures(t *testing.T) {
 		}
 	}
 }
+
+func TestCompareTargets(t *testing.T) {
+	for _, tc := range []struct {
+		a, b     Targets
+		expected int
+	}{
+		{Targets{"1.2.3.4", "8.8.8.8"}, Targets{"8.8.8.8", "1.2.3.4"}, 0},
+		{Targets{"1.2.3.4"}, Targets{"1.2.3.4", "8.8.8.8"}, -1},
+		{Targets{"1.2.3.4", "8.8.8.8"}, Targets{"1.2.3.4"}, 1},
+		{Targets{"8.8.8.8", "1.2.3.4"}, Targets{"8.8.4.4", "1.2.3.4"}, 1},
+		{Targets{"8.8.4.4", "1.2.3.4"}, Targets{"1.2.3.4", "8.8.8.8"}, -1},
+	} {
+		a, b := NewTargets(tc.a...), NewTargets(tc.b...)
+		if got := CompareTargets(a, b); got != tc.expected {
+			t.Errorf("expected CompareTargets(%v, %v) to be %d, got %d", tc.a, tc.b, tc.expected, got)
+		}
+		if got := a.IsLess(b); got != (tc.expected < 0) {
+			t.Errorf("expected %v.IsLess(%v) to be %t, got %t", tc.a, tc.b, tc.expected < 0, got)
+		}
+		if !reflect.DeepEqual(a, tc.a) || !reflect.DeepEqual(b, tc.b) {
+			t.Errorf("targets were reordered: %v, %v", a, b)
+		}
+	}
+}
+
+func TestTargetsCanonical(t *testing.T) {
+	a := Targets{"8.8.8.8", "1.2.3.4", "8.8.4.4"}
+	b := Targets{"8.8.4.4", "8.8.8.8", "1.2.3.4"}
+	if !reflect.DeepEqual(a.Canonical(), b.Canonical()) {
+		t.Errorf("expected identical canonical targets, got %v and %v", a.Canonical(), b.Canonical())
+	}
+	if !reflect.DeepEqual(a.Canonical(), Targets{"1.2.3.4", "8.8.4.4", "8.8.8.8"}) {
+		t.Errorf("unexpected canonical targets %v", a.Canonical())
+	}
+}
+
+func TestTargetsFilter(t *testing.T) {
+	targets := Targets{"foo.example.org", "bar.example.org", "1.2.3.4"}
+	filtered := targets.Filter(func(target string) bool { return target != "bar.example.org" })
+	if !reflect.DeepEqual(filtered, Targets{"foo.example.org", "1.2.3.4"}) {
+		t.Errorf("unexpected filtered targets %v", filtered)
+	}
+	if len(targets.Filter(func(string) bool { return false })) != 0 {
+		t.Error("expected all targets to be filtered")
+	}
+}
+
+func TestTargetsValidIPs(t *testing.T) {
+	targets := Targets{"1.2.3.4", "2001:db8::1", "example.org", "1.2.3", "", "::ffff:10.0.0.1", "10.0.0.1"}
+	for _, tc := range []struct {
+		recordType string
+		expected   Targets
+	}{
+		{RecordTypeA, Targets{"1.2.3.4", "10.0.0.1"}},
+		{RecordTypeAAAA, Targets{"2001:db8::1", "::ffff:10.0.0.1"}},
+		{RecordTypeCNAME, Targets{}},
+		{"", Targets{}},
+		{"ipv4", Targets{}},
+	} {
+		if valid := targets.ValidIPs(tc.recordType); !reflect.DeepEqual(valid, tc.expected) {
+			t.Errorf("record type %q: expected %v, got %v", tc.recordType, tc.expected, valid)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	for _, tc := range []struct {
+		a, b *Endpoint
+		same bool
+	}{
+		{NewEndpoint("example.org", RecordTypeA), NewEndpoint("Example.ORG", RecordTypeA), true},
+		{&Endpoint{DNSName: "example.org.", RecordType: "cname"}, &Endpoint{DNSName: "example.org", RecordType: RecordTypeCNAME}, true},
+		{NewEndpoint("example.org", RecordTypeA), NewEndpoint("example.org", RecordTypeTXT), false},
+		{NewEndpoint("foo.example.org", RecordTypeA), NewEndpoint("bar.example.org", RecordTypeA), false},
+	} {
+		if (tc.a.Key() == tc.b.Key()) != tc.same {
+			t.Errorf("expected keys of %v and %v to be same=%t, got %q and %q", tc.a, tc.b, tc.same, tc.a.Key(), tc.b.Key())
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	base := func() *Endpoint {
+		return &Endpoint{
+			DNSName:          "example.org",
+			RecordType:       RecordTypeA,
+			RecordTTL:        300,
+			Targets:          Targets{"1.2.3.4", "5.6.7.8"},
+			Labels:           Labels{OwnerLabelKey: "foo"},
+			ProviderSpecific: ProviderSpecific{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}},
+		}
+	}
+
+	for _, tc := range []struct {
+		title    string
+		modify   func(*Endpoint)
+		expected bool
+	}{
+		{"identical", func(*Endpoint) {}, true},
+		{"different target order", func(e *Endpoint) { e.Targets = Targets{"5.6.7.8", "1.2.3.4"} }, true},
+		{"different DNS name case and trailing dot", func(e *Endpoint) { e.DNSName = "Example.org." }, true},
+		{"different provider specific order", func(e *Endpoint) {
+			e.ProviderSpecific = ProviderSpecific{{Name: "b", Value: "2"}, {Name: "a", Value: "1"}}
+		}, true},
+		{"different TTL", func(e *Endpoint) { e.RecordTTL = 60 }, false},
+		{"different record type", func(e *Endpoint) { e.RecordType = RecordTypeCNAME }, false},
+		{"different targets", func(e *Endpoint) { e.Targets = Targets{"1.2.3.4"} }, false},
+		{"different labels", func(e *Endpoint) { e.Labels = Labels{OwnerLabelKey: "bar"} }, false},
+		{"missing labels", func(e *Endpoint) { e.Labels = nil }, false},
+		{"different provider specific", func(e *Endpoint) { e.ProviderSpecific = ProviderSpecific{{Name: "a", Value: "1"}} }, false},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			e, o := base(), base()
+			tc.modify(o)
+			if e.Equal(o) != tc.expected || o.Equal(e) != tc.expected {
+				t.Errorf("expected %v and %v to be equal=%t", e, o, tc.expected)
+			}
+		})
+	}
+
+	var nilEndpoint *Endpoint
+	if !nilEndpoint.Equal(nil) || nilEndpoint.Equal(base()) || base().Equal(nil) {
+		t.Error("unexpected comparison result for nil endpoints")
+	}
+}
+
+func TestMergeEndpoints(t *testing.T) {
+	for _, tc := range []struct {
+		title    string
+		existing []*Endpoint
+		incoming []*Endpoint
+		expected []*Endpoint
+	}{
+		{
+			title:    "same key unions targets",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}}},
+			incoming: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4", "5.6.7.8"}}},
+			expected: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4", "5.6.7.8"}, Labels: Labels{}}},
+		},
+		{
+			title:    "duplicate targets within a single endpoint are dropped",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4", "1.2.3.4"}}},
+			incoming: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"5.6.7.8", "5.6.7.8"}}},
+			expected: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4", "5.6.7.8"}, Labels: Labels{}}},
+		},
+		{
+			title:    "incoming labels and TTL take precedence",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, RecordTTL: 60, Targets: Targets{"1.2.3.4"}, Labels: Labels{"owner": "a", "resource": "x"}}},
+			incoming: []*Endpoint{{DNSName: "Example.org.", RecordType: RecordTypeA, RecordTTL: 300, Targets: Targets{"1.2.3.4"}, Labels: Labels{"owner": "b"}}},
+			expected: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, RecordTTL: 300, Targets: Targets{"1.2.3.4"}, Labels: Labels{"owner": "b", "resource": "x"}}},
+		},
+		{
+			title:    "unconfigured incoming TTL keeps existing TTL",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, RecordTTL: 60, Targets: Targets{"1.2.3.4"}}},
+			incoming: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}}},
+			expected: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, RecordTTL: 60, Targets: Targets{"1.2.3.4"}, Labels: Labels{}}},
+		},
+		{
+			title:    "different record types are kept separate",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}}},
+			incoming: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeTXT, Targets: Targets{"text"}}},
+			expected: []*Endpoint{
+				{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}, Labels: Labels{}},
+				{DNSName: "example.org", RecordType: RecordTypeTXT, Targets: Targets{"text"}, Labels: Labels{}},
+			},
+		},
+		{
+			title:    "existing provider specific is kept without incoming provider specific",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}, ProviderSpecific: ProviderSpecific{{Name: "a", Value: "1"}}}},
+			incoming: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"5.6.7.8"}}},
+			expected: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4", "5.6.7.8"}, Labels: Labels{}, ProviderSpecific: ProviderSpecific{{Name: "a", Value: "1"}}}},
+		},
+		{
+			title:    "incoming provider specific takes precedence",
+			existing: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}, ProviderSpecific: ProviderSpecific{{Name: "a", Value: "1"}}}},
+			incoming: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}, ProviderSpecific: ProviderSpecific{{Name: "b", Value: "2"}}}},
+			expected: []*Endpoint{{DNSName: "example.org", RecordType: RecordTypeA, Targets: Targets{"1.2.3.4"}, Labels: Labels{}, ProviderSpecific: ProviderSpecific{{Name: "b", Value: "2"}}}},
+		},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			var existing, incoming []*Endpoint
+			for _, ep := range tc.existing {
+				existing = append(existing, ep.DeepCopy())
+			}
+			for _, ep := range tc.incoming {
+				incoming = append(incoming, ep.DeepCopy())
+			}
+
+			merged := MergeEndpoints(tc.existing, tc.incoming)
+			if !reflect.DeepEqual(merged, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, merged)
+			}
+
+			// writing to the merged endpoints must not leak into the passed endpoints
+			for _, ep := range merged {
+				for i := range ep.Targets {
+					ep.Targets[i] = "modified"
+				}
+				for i := range ep.ProviderSpecific {
+					ep.ProviderSpecific[i].Value = "modified"
+				}
+				ep.Labels["modified"] = "modified"
+			}
+			if !reflect.DeepEqual(tc.existing, existing) {
+				t.Errorf("existing endpoints were modified: %v", tc.existing)
+			}
+			if !reflect.DeepEqual(tc.incoming, incoming) {
+				t.Errorf("incoming endpoints were modified: %v", tc.incoming)
+			}
+		})
+	}
+}
+
+func TestEndpointStringRedactTXT(t *testing.T) {
+	txt := NewEndpoint("example.org", RecordTypeTXT, "acme-token", "other-token")
+	a := NewEndpoint("example.org", RecordTypeA, "1.2.3.4")
+
+	if got := txt.String(); got != "example.org 0 IN TXT acme-token;other-token []" {
+		t.Errorf("unexpected string without redaction: %s", got)
+	}
+
+	RedactTXT = true
+	defer func() { RedactTXT = false }()
+
+	if got := txt.String(); got != "example.org 0 IN TXT <redacted>;<redacted> []" {
+		t.Errorf("unexpected string with redaction: %s", got)
+	}
+	if got := a.String(); got != "example.org 0 IN A 1.2.3.4 []" {
+		t.Errorf("non-TXT targets should not be redacted: %s", got)
+	}
+	if !txt.Targets.Same(Targets{"acme-token", "other-token"}) {
+		t.Errorf("redaction should not modify the targets: %v", []string(txt.Targets))
+	}
+	if got := RedactTarget(RecordTypeTXT, ""); got != "" {
+		t.Errorf("empty targets should stay empty: %s", got)
+	}
+}
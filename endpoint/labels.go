@@ -82,6 +82,30 @@ func NewLabelsFromString(labelText string) (Labels, error) {
 	return endpointLabels, nil
 }
 
+// Normalize returns a copy of the labels with surrounding whitespace trimmed from keys and values and
+// entries with an empty key or value dropped. If several keys collapse into the same key after trimming,
+// the value of an already trimmed key wins, otherwise the one of the lexically first key.
+func (l Labels) Normalize() Labels {
+	var keys []string
+	for key := range l {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // sort for consistency
+
+	normalized := NewLabels()
+	for _, key := range keys {
+		k, v := strings.TrimSpace(key), strings.TrimSpace(l[key])
+		if k == "" || v == "" {
+			continue
+		}
+		if _, ok := normalized[k]; ok && k != key {
+			continue
+		}
+		normalized[k] = v
+	}
+	return normalized
+}
+
 // Serialize transforms endpoints labels into a external-dns recognizable format string
 // withQuotes adds additional quotes
 func (l Labels) Serialize(withQuotes bool) string {
@@ -87,6 +87,23 @@ func (suite *LabelsSuite) TestDeserialize() {
 	suite.Nil(multipleHeritage, "if error should return nil")
 }
 
+func (suite *LabelsSuite) TestNormalize() {
+	labels := Labels{
+		" owner":     "first-owner",
+		"owner":      "foo-owner ",
+		"owner  ":    "other-owner",
+		"resource  ": " foo-resource",
+		"empty":      "  ",
+		"   ":        "no-key",
+	}
+	suite.Equal(suite.foo, labels.Normalize(), "should trim, drop empty entries and dedupe")
+	suite.Equal(suite.foo, suite.foo.Normalize(), "should keep clean labels unchanged")
+	suite.Equal(NewLabels(), Labels(nil).Normalize(), "should return empty labels for nil labels")
+
+	labels = Labels{" owner": "first-owner", "owner  ": "other-owner"}
+	suite.Equal(Labels{"owner": "first-owner"}, labels.Normalize(), "should prefer the lexically first key")
+}
+
 func TestLabels(t *testing.T) {
 	suite.Run(t, new(LabelsSuite))
 }
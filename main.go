@@ -17,6 +17,8 @@ limitations under the License.
 package main
 
 import (
+	"context"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -28,6 +30,7 @@ import (
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	"github.com/kubernetes-incubator/external-dns/controller"
+	"github.com/kubernetes-incubator/external-dns/endpoint"
 	"github.com/kubernetes-incubator/external-dns/pkg/apis/externaldns"
 	"github.com/kubernetes-incubator/external-dns/pkg/apis/externaldns/validation"
 	"github.com/kubernetes-incubator/external-dns/plan"
@@ -50,6 +53,7 @@ func main() {
 	if cfg.LogFormat == "json" {
 		log.SetFormatter(&log.JSONFormatter{})
 	}
+	endpoint.RedactTXT = cfg.RedactTXT
 	if cfg.DryRun {
 		log.Info("running in dry-run mode. No changes to DNS records will be made.")
 	}
@@ -62,7 +66,15 @@ func main() {
 
 	stopChan := make(chan struct{}, 1)
 
-	go serveMetrics(cfg.MetricsAddress)
+	listeners, err := listenMetrics(cfg.MetricsAddresses)
+	if err != nil {
+		log.Fatal(err)
+	}
+	go func() {
+		if err := serveMetrics(listeners, cfg.HealthzPath, stopChan); err != nil {
+			log.Fatal(err)
+		}
+	}()
 	go handleSigterm(stopChan)
 
 	// Create a source.Config from the flags passed by the user.
@@ -97,7 +109,7 @@ func main() {
 	// Combine multiple sources into a single, deduplicated source.
 	endpointsSource := source.NewDedupSource(source.NewMultiSource(sources))
 
-	domainFilter := provider.NewDomainFilter(cfg.DomainFilter)
+	domainFilter := provider.NewDomainFilterWithExclusions(cfg.DomainFilter, cfg.ExcludeDomains)
 	zoneIDFilter := provider.NewZoneIDFilter(cfg.ZoneIDFilter)
 	zoneTypeFilter := provider.NewZoneTypeFilter(cfg.AWSZoneType)
 	zoneTagFilter := provider.NewZoneTagFilter(cfg.AWSZoneTagFilter)
@@ -238,10 +250,14 @@ func main() {
 	}
 
 	ctrl := controller.Controller{
-		Source:   endpointsSource,
-		Registry: r,
-		Policy:   policy,
-		Interval: cfg.Interval,
+		Source:            endpointsSource,
+		Registry:          r,
+		Policy:            policy,
+		Interval:          cfg.Interval,
+		DomainFilter:      domainFilter,
+		MaxDeleteFraction: cfg.MaxDeleteFraction,
+		ForceDelete:       cfg.ForceDelete,
+		DryRun:            cfg.DryRun,
 	}
 
 	if cfg.Once {
@@ -263,13 +279,63 @@ func handleSigterm(stopChan chan struct{}) {
 	close(stopChan)
 }
 
-func serveMetrics(address string) {
-	http.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+// listenMetrics binds all given addresses up front, so that an unusable address fails at startup.
+func listenMetrics(addresses []string) ([]net.Listener, error) {
+	listeners := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		l, err := net.Listen("tcp", address)
+		if err != nil {
+			for _, l := range listeners {
+				l.Close()
+			}
+			return nil, err
+		}
+		listeners = append(listeners, l)
+	}
+	return listeners, nil
+}
+
+// serveMetrics serves the health check and metrics on all listeners until stopChan is closed or one of them fails.
+// Either way all servers are shut down before it returns.
+func serveMetrics(listeners []net.Listener, healthzPath string, stopChan <-chan struct{}) error {
+	mux := newMetricsMux(healthzPath)
+	servers := make([]*http.Server, 0, len(listeners))
+	errs := make(chan error, len(listeners))
+
+	for _, l := range listeners {
+		server := &http.Server{Handler: mux}
+		servers = append(servers, server)
+		go func(l net.Listener) {
+			errs <- server.Serve(l)
+		}(l)
+	}
+
+	var err error
+	select {
+	case err = <-errs:
+	case <-stopChan:
+	}
+
+	for _, server := range servers {
+		server.Shutdown(context.Background())
+	}
+
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return err
+}
+
+// newMetricsMux returns the handler serving the health check at healthzPath and the metrics at /metrics.
+func newMetricsMux(healthzPath string) *http.ServeMux {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(healthzPath, func(w http.ResponseWriter, _ *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte("OK"))
 	})
 
-	http.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/metrics", promhttp.Handler())
 
-	log.Fatal(http.ListenAndServe(address, nil))
+	return mux
 }
@@ -0,0 +1,79 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsMux(t *testing.T) {
+	for _, tc := range []struct {
+		title       string
+		healthzPath string
+		path        string
+		expected    int
+	}{
+		{"default health check path", "/healthz", "/healthz", http.StatusOK},
+		{"custom health check path", "/custom/health", "/custom/health", http.StatusOK},
+		{"default path with custom health check path", "/custom/health", "/healthz", http.StatusNotFound},
+		{"metrics with custom health check path", "/custom/health", "/metrics", http.StatusOK},
+	} {
+		t.Run(tc.title, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			newMetricsMux(tc.healthzPath).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.path, nil))
+			assert.Equal(t, tc.expected, rec.Code)
+		})
+	}
+}
+
+func TestServeMetricsMultipleAddresses(t *testing.T) {
+	listeners, err := listenMetrics([]string{"127.0.0.1:0", "127.0.0.1:0"})
+	require.NoError(t, err)
+	require.Len(t, listeners, 2)
+
+	stopChan := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- serveMetrics(listeners, "/healthz", stopChan)
+	}()
+
+	for _, l := range listeners {
+		resp, err := http.Get("http://" + l.Addr().String() + "/healthz")
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "health check on %s", l.Addr())
+	}
+
+	close(stopChan)
+	assert.NoError(t, <-done)
+
+	// all listeners are closed once serving stopped
+	for _, l := range listeners {
+		_, err := http.Get("http://" + l.Addr().String() + "/healthz")
+		assert.Error(t, err, "health check on %s after shutdown", l.Addr())
+	}
+}
+
+func TestListenMetricsInvalidAddress(t *testing.T) {
+	_, err := listenMetrics([]string{"127.0.0.1:0", "invalid:address:0"})
+	assert.Error(t, err)
+}
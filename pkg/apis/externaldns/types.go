@@ -54,6 +54,7 @@ type Config struct {
 	Provider                    string
 	GoogleProject               string
 	DomainFilter                []string
+	ExcludeDomains              []string
 	ZoneIDFilter                []string
 	AlibabaCloudConfigFile      string
 	AlibabaCloudZoneType        string
@@ -89,6 +90,8 @@ type Config struct {
 	TLSClientCert               string
 	TLSClientCertKey            string
 	Policy                      string
+	MaxDeleteFraction           float64
+	ForceDelete                 bool
 	Registry                    string
 	TXTOwnerID                  string
 	TXTPrefix                   string
@@ -96,7 +99,9 @@ type Config struct {
 	Once                        bool
 	DryRun                      bool
 	LogFormat                   string
-	MetricsAddress              string
+	RedactTXT                   bool
+	MetricsAddresses            []string
+	HealthzPath                 string
 	LogLevel                    string
 	TXTCacheInterval            time.Duration
 	ExoscaleEndpoint            string
@@ -133,6 +138,7 @@ var defaultConfig = &Config{
 	Provider:                    "",
 	GoogleProject:               "",
 	DomainFilter:                []string{},
+	ExcludeDomains:              []string{},
 	AlibabaCloudConfigFile:      "/etc/kubernetes/alibaba-cloud.json",
 	AWSZoneType:                 "",
 	AWSZoneTagFilter:            []string{},
@@ -162,6 +168,8 @@ var defaultConfig = &Config{
 	TLSClientCert:               "",
 	TLSClientCertKey:            "",
 	Policy:                      "sync",
+	MaxDeleteFraction:           0.5,
+	ForceDelete:                 false,
 	Registry:                    "txt",
 	TXTOwnerID:                  "default",
 	TXTPrefix:                   "",
@@ -170,7 +178,9 @@ var defaultConfig = &Config{
 	Once:                        false,
 	DryRun:                      false,
 	LogFormat:                   "text",
-	MetricsAddress:              ":7979",
+	RedactTXT:                   false,
+	MetricsAddresses:            []string{":7979"},
+	HealthzPath:                 "/healthz",
 	LogLevel:                    logrus.InfoLevel.String(),
 	ExoscaleEndpoint:            "https://api.exoscale.ch/dns",
 	ExoscaleAPIKey:              "",
@@ -255,6 +265,7 @@ func (cfg *Config) ParseFlags(args []string) error {
 	// Flags related to providers
 	app.Flag("provider", "The DNS provider where the DNS records will be created (required, options: aws, aws-sd, google, azure, cloudflare, rcodezero, digitalocean, dnsimple, infoblox, dyn, designate, coredns, skydns, inmemory, pdns, oci, exoscale, linode, rfc2136, ns1)").Required().PlaceHolder("provider").EnumVar(&cfg.Provider, "aws", "aws-sd", "google", "azure", "alibabacloud", "cloudflare", "rcodezero", "digitalocean", "dnsimple", "infoblox", "dyn", "designate", "coredns", "skydns", "inmemory", "pdns", "oci", "exoscale", "linode", "rfc2136", "ns1")
 	app.Flag("domain-filter", "Limit possible target zones by a domain suffix; specify multiple times for multiple domains (optional)").Default("").StringsVar(&cfg.DomainFilter)
+	app.Flag("exclude-domains", "Exclude domains and their subdomains from management, taking precedence over --domain-filter and applying to all domains if it is not set; specify multiple times for multiple domains (optional)").Default("").StringsVar(&cfg.ExcludeDomains)
 	app.Flag("zone-id-filter", "Filter target zones by hosted zone id; specify multiple times for multiple zones (optional)").Default("").StringsVar(&cfg.ZoneIDFilter)
 	app.Flag("google-project", "When using the Google provider, current project is auto-detected, when running on GCP. Specify other project with this. Must be specified when running outside GCP.").Default(defaultConfig.GoogleProject).StringVar(&cfg.GoogleProject)
 	app.Flag("alibaba-cloud-config-file", "When using the Alibaba Cloud provider, specify the Alibaba Cloud configuration file (required when --provider=alibabacloud").Default(defaultConfig.AlibabaCloudConfigFile).StringVar(&cfg.AlibabaCloudConfigFile)
@@ -310,6 +321,8 @@ func (cfg *Config) ParseFlags(args []string) error {
 
 	// Flags related to policies
 	app.Flag("policy", "Modify how DNS records are synchronized between sources and providers (default: sync, options: sync, upsert-only)").Default(defaultConfig.Policy).EnumVar(&cfg.Policy, "sync", "upsert-only")
+	app.Flag("max-delete-fraction", "Refuse to apply a synchronization that deletes more than this fraction of the current records (default: 0.5, 0 disables the check)").Default(strconv.FormatFloat(defaultConfig.MaxDeleteFraction, 'f', -1, 64)).Float64Var(&cfg.MaxDeleteFraction)
+	app.Flag("force-delete", "When enabled, applies deletions even if they exceed --max-delete-fraction (default: disabled)").BoolVar(&cfg.ForceDelete)
 
 	// Flags related to the registry
 	app.Flag("registry", "The registry implementation to use to keep track of DNS record ownership (default: txt, options: txt, noop, aws-sd)").Default(defaultConfig.Registry).EnumVar(&cfg.Registry, "txt", "noop", "aws-sd")
@@ -324,7 +337,9 @@ func (cfg *Config) ParseFlags(args []string) error {
 
 	// Miscellaneous flags
 	app.Flag("log-format", "The format in which log messages are printed (default: text, options: text, json)").Default(defaultConfig.LogFormat).EnumVar(&cfg.LogFormat, "text", "json")
-	app.Flag("metrics-address", "Specify where to serve the metrics and health check endpoint (default: :7979)").Default(defaultConfig.MetricsAddress).StringVar(&cfg.MetricsAddress)
+	app.Flag("redact-txt", "When enabled, hides the values of TXT records in logs while still logging their names (default: disabled)").BoolVar(&cfg.RedactTXT)
+	app.Flag("metrics-address", "Specify where to serve the metrics and health check endpoint; specify multiple times for multiple addresses (default: :7979)").Default(defaultConfig.MetricsAddresses...).StringsVar(&cfg.MetricsAddresses)
+	app.Flag("healthz-path", "Specify the path of the health check endpoint served on the metrics address (default: /healthz)").Default(defaultConfig.HealthzPath).StringVar(&cfg.HealthzPath)
 	app.Flag("log-level", "Set the level of logging. (default: info, options: panic, debug, info, warning, error, fatal").Default(defaultConfig.LogLevel).EnumVar(&cfg.LogLevel, allLogLevelsAsStrings()...)
 
 	_, err := app.Parse(args)
@@ -40,6 +40,7 @@ var (
 		Provider:                    "google",
 		GoogleProject:               "",
 		DomainFilter:                []string{""},
+		ExcludeDomains:              []string{""},
 		ZoneIDFilter:                []string{""},
 		AlibabaCloudConfigFile:      "/etc/kubernetes/alibaba-cloud.json",
 		AWSZoneType:                 "",
@@ -65,6 +66,8 @@ var (
 		PDNSServer:                  "http://localhost:8081",
 		PDNSAPIKey:                  "",
 		Policy:                      "sync",
+		MaxDeleteFraction:           0.5,
+		ForceDelete:                 false,
 		Registry:                    "txt",
 		TXTOwnerID:                  "default",
 		TXTPrefix:                   "",
@@ -73,7 +76,9 @@ var (
 		Once:                        false,
 		DryRun:                      false,
 		LogFormat:                   "text",
-		MetricsAddress:              ":7979",
+		RedactTXT:                   false,
+		MetricsAddresses:            []string{":7979"},
+		HealthzPath:                 "/healthz",
 		LogLevel:                    logrus.InfoLevel.String(),
 		ConnectorSourceServer:       "localhost:8080",
 		ExoscaleEndpoint:            "https://api.exoscale.ch/dns",
@@ -97,6 +102,7 @@ var (
 		Provider:                    "google",
 		GoogleProject:               "project",
 		DomainFilter:                []string{"example.org", "company.com"},
+		ExcludeDomains:              []string{"xyz.example.org", "xyz.company.com"},
 		ZoneIDFilter:                []string{"/hostedzone/ZTST1", "/hostedzone/ZTST2"},
 		AlibabaCloudConfigFile:      "/etc/kubernetes/alibaba-cloud.json",
 		AWSZoneType:                 "private",
@@ -126,6 +132,8 @@ var (
 		TLSClientCert:               "/path/to/cert.pem",
 		TLSClientCertKey:            "/path/to/key.pem",
 		Policy:                      "upsert-only",
+		MaxDeleteFraction:           0.9,
+		ForceDelete:                 true,
 		Registry:                    "noop",
 		TXTOwnerID:                  "owner-1",
 		TXTPrefix:                   "associated-txt-record",
@@ -134,7 +142,9 @@ var (
 		Once:                        true,
 		DryRun:                      true,
 		LogFormat:                   "json",
-		MetricsAddress:              "127.0.0.1:9099",
+		RedactTXT:                   true,
+		MetricsAddresses:            []string{"127.0.0.1:9099", "[::1]:9099"},
+		HealthzPath:                 "/health",
 		LogLevel:                    logrus.DebugLevel.String(),
 		ConnectorSourceServer:       "localhost:8081",
 		ExoscaleEndpoint:            "https://api.foo.ch/dns",
@@ -158,6 +168,7 @@ var (
 		Provider:                    "google",
 		GoogleProject:               "",
 		DomainFilter:                []string{""},
+		ExcludeDomains:              []string{""},
 		ZoneIDFilter:                []string{""},
 		AlibabaCloudConfigFile:      "/etc/kubernetes/alibaba-cloud.json",
 		AWSZoneType:                 "",
@@ -183,6 +194,8 @@ var (
 		PDNSServer:                  "http://localhost:8081",
 		PDNSAPIKey:                  "",
 		Policy:                      "sync",
+		MaxDeleteFraction:           0.5,
+		ForceDelete:                 false,
 		Registry:                    "txt",
 		TXTOwnerID:                  "default",
 		TXTPrefix:                   "",
@@ -191,7 +204,9 @@ var (
 		Once:                        false,
 		DryRun:                      false,
 		LogFormat:                   "text",
-		MetricsAddress:              ":7979",
+		RedactTXT:                   false,
+		MetricsAddresses:            []string{":7979"},
+		HealthzPath:                 "/healthz",
 		LogLevel:                    logrus.InfoLevel.String(),
 		ConnectorSourceServer:       "localhost:8080",
 		ExoscaleEndpoint:            "https://api.exoscale.ch/dns",
@@ -257,6 +272,8 @@ func TestParseFlags(t *testing.T) {
 				"--no-infoblox-ssl-verify",
 				"--domain-filter=example.org",
 				"--domain-filter=company.com",
+				"--exclude-domains=xyz.example.org",
+				"--exclude-domains=xyz.company.com",
 				"--zone-id-filter=/hostedzone/ZTST1",
 				"--zone-id-filter=/hostedzone/ZTST2",
 				"--aws-zone-type=private",
@@ -267,6 +284,8 @@ func TestParseFlags(t *testing.T) {
 				"--aws-api-retries=13",
 				"--no-aws-evaluate-target-health",
 				"--policy=upsert-only",
+				"--max-delete-fraction=0.9",
+				"--force-delete",
 				"--registry=noop",
 				"--txt-owner-id=owner-1",
 				"--txt-prefix=associated-txt-record",
@@ -275,7 +294,10 @@ func TestParseFlags(t *testing.T) {
 				"--once",
 				"--dry-run",
 				"--log-format=json",
+				"--redact-txt",
 				"--metrics-address=127.0.0.1:9099",
+				"--metrics-address=[::1]:9099",
+				"--healthz-path=/health",
 				"--log-level=debug",
 				"--connector-source-server=localhost:8081",
 				"--exoscale-endpoint=https://api.foo.ch/dns",
@@ -317,6 +339,7 @@ func TestParseFlags(t *testing.T) {
 				"EXTERNAL_DNS_OCI_CONFIG_FILE":            "oci.yaml",
 				"EXTERNAL_DNS_INMEMORY_ZONE":              "example.org\ncompany.com",
 				"EXTERNAL_DNS_DOMAIN_FILTER":              "example.org\ncompany.com",
+				"EXTERNAL_DNS_EXCLUDE_DOMAINS":            "xyz.example.org\nxyz.company.com",
 				"EXTERNAL_DNS_PDNS_SERVER":                "http://ns.example.com:8081",
 				"EXTERNAL_DNS_PDNS_API_KEY":               "some-secret-key",
 				"EXTERNAL_DNS_PDNS_TLS_ENABLED":           "1",
@@ -332,6 +355,8 @@ func TestParseFlags(t *testing.T) {
 				"EXTERNAL_DNS_AWS_EVALUATE_TARGET_HEALTH": "0",
 				"EXTERNAL_DNS_AWS_API_RETRIES":            "13",
 				"EXTERNAL_DNS_POLICY":                     "upsert-only",
+				"EXTERNAL_DNS_MAX_DELETE_FRACTION":        "0.9",
+				"EXTERNAL_DNS_FORCE_DELETE":               "1",
 				"EXTERNAL_DNS_REGISTRY":                   "noop",
 				"EXTERNAL_DNS_TXT_OWNER_ID":               "owner-1",
 				"EXTERNAL_DNS_TXT_PREFIX":                 "associated-txt-record",
@@ -340,7 +365,9 @@ func TestParseFlags(t *testing.T) {
 				"EXTERNAL_DNS_ONCE":                       "1",
 				"EXTERNAL_DNS_DRY_RUN":                    "1",
 				"EXTERNAL_DNS_LOG_FORMAT":                 "json",
-				"EXTERNAL_DNS_METRICS_ADDRESS":            "127.0.0.1:9099",
+				"EXTERNAL_DNS_REDACT_TXT":                 "1",
+				"EXTERNAL_DNS_METRICS_ADDRESS":            "127.0.0.1:9099\n[::1]:9099",
+				"EXTERNAL_DNS_HEALTHZ_PATH":               "/health",
 				"EXTERNAL_DNS_LOG_LEVEL":                  "debug",
 				"EXTERNAL_DNS_CONNECTOR_SOURCE_SERVER":    "localhost:8081",
 				"EXTERNAL_DNS_EXOSCALE_ENDPOINT":          "https://api.foo.ch/dns",
@@ -352,6 +379,18 @@ func TestParseFlags(t *testing.T) {
 			},
 			expected: overriddenConfig,
 		},
+		{
+			title: "flags take precedence over environment variables",
+			args: []string{
+				"--source=service",
+				"--provider=google",
+			},
+			envVars: map[string]string{
+				"EXTERNAL_DNS_SOURCE":   "ingress",
+				"EXTERNAL_DNS_PROVIDER": "aws",
+			},
+			expected: minimalConfig,
+		},
 		{
 			title: "istio config with 2 ingressgateways",
 			args: []string{
@@ -19,6 +19,7 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/kubernetes-incubator/external-dns/pkg/apis/externaldns"
 )
@@ -35,6 +36,15 @@ func ValidateConfig(cfg *externaldns.Config) error {
 	if cfg.Provider == "" {
 		return errors.New("no provider specified")
 	}
+	if !strings.HasPrefix(cfg.HealthzPath, "/") {
+		return fmt.Errorf("health check path must start with a slash: %q", cfg.HealthzPath)
+	}
+	if cfg.HealthzPath == "/metrics" {
+		return errors.New("health check path must not collide with the metrics path /metrics")
+	}
+	if cfg.MaxDeleteFraction < 0 || cfg.MaxDeleteFraction > 1 {
+		return fmt.Errorf("max delete fraction must be between 0 and 1: %v", cfg.MaxDeleteFraction)
+	}
 
 	// Azure provider specific validations
 	if cfg.Provider == "azure" {
@@ -66,6 +76,31 @@ func ValidateConfig(cfg *externaldns.Config) error {
 		}
 	}
 
+	// PDNS provider specific validations
+	if cfg.Provider == "pdns" {
+		if cfg.PDNSTLSEnabled && cfg.TLSCA == "" {
+			return errors.New("no TLS certificate authority specified while PDNS TLS is enabled")
+		}
+	}
+
+	// RFC2136 provider specific validations
+	if cfg.Provider == "rfc2136" && !cfg.RFC2136Insecure {
+		if cfg.RFC2136TSIGKeyName == "" {
+			return errors.New("no RFC2136 TSIG key name specified while not running insecure")
+		}
+		if cfg.RFC2136TSIGSecret == "" {
+			return errors.New("no RFC2136 TSIG secret specified while not running insecure")
+		}
+	}
+
+	// TLS specific validations
+	if cfg.TLSClientCert != "" && cfg.TLSClientCertKey == "" {
+		return errors.New("TLS client certificate specified without its key")
+	}
+	if cfg.TLSClientCert == "" && cfg.TLSClientCertKey != "" {
+		return errors.New("TLS client certificate key specified without its certificate")
+	}
+
 	if cfg.IgnoreHostnameAnnotation && cfg.FQDNTemplate == "" {
 		return errors.New("FQDN Template must be set if ignoring annotations")
 	}
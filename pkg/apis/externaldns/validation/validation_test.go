@@ -50,6 +50,30 @@ func TestValidateFlags(t *testing.T) {
 	cfg = newValidConfig(t)
 	cfg.Provider = ""
 	assert.Error(t, ValidateConfig(cfg))
+
+	for _, path := range []string{"", "healthz", "/metrics"} {
+		cfg = newValidConfig(t)
+		cfg.HealthzPath = path
+		assert.Error(t, ValidateConfig(cfg), "health check path %q should NOT have passed validation", path)
+	}
+
+	for _, path := range []string{"/healthz", "/", "/custom/health"} {
+		cfg = newValidConfig(t)
+		cfg.HealthzPath = path
+		assert.NoError(t, ValidateConfig(cfg), "health check path %q should have passed validation", path)
+	}
+
+	for _, fraction := range []float64{-0.1, 1.1} {
+		cfg = newValidConfig(t)
+		cfg.MaxDeleteFraction = fraction
+		assert.Error(t, ValidateConfig(cfg), "max delete fraction %v should NOT have passed validation", fraction)
+	}
+
+	for _, fraction := range []float64{0, 0.5, 1} {
+		cfg = newValidConfig(t)
+		cfg.MaxDeleteFraction = fraction
+		assert.NoError(t, ValidateConfig(cfg), "max delete fraction %v should have passed validation", fraction)
+	}
 }
 
 func newValidConfig(t *testing.T) *externaldns.Config {
@@ -58,6 +82,7 @@ func newValidConfig(t *testing.T) *externaldns.Config {
 	cfg.LogFormat = "json"
 	cfg.Sources = []string{"test-source"}
 	cfg.Provider = "test-provider"
+	cfg.HealthzPath = "/healthz"
 
 	require.NoError(t, ValidateConfig(cfg))
 
@@ -68,6 +93,7 @@ func addRequiredFieldsForDyn(cfg *externaldns.Config) {
 	cfg.LogFormat = "json"
 	cfg.Sources = []string{"ingress"}
 	cfg.Provider = "dyn"
+	cfg.HealthzPath = "/healthz"
 }
 
 func TestValidateBadDynConfig(t *testing.T) {
@@ -124,3 +150,72 @@ func TestValidateBadIgnoreHostnameAnnotationsConfig(t *testing.T) {
 
 	assert.Error(t, ValidateConfig(cfg))
 }
+
+func TestValidateBadTLSConfig(t *testing.T) {
+	cfg := newValidConfig(t)
+	cfg.TLSClientCert = "/path/to/cert.pem"
+	assert.Error(t, ValidateConfig(cfg))
+
+	cfg = newValidConfig(t)
+	cfg.TLSClientCertKey = "/path/to/key.pem"
+	assert.Error(t, ValidateConfig(cfg))
+
+	cfg = newValidConfig(t)
+	cfg.TLSClientCert = "/path/to/cert.pem"
+	cfg.TLSClientCertKey = "/path/to/key.pem"
+	assert.NoError(t, ValidateConfig(cfg))
+}
+
+func TestValidatePDNSConfig(t *testing.T) {
+	cfg := newValidConfig(t)
+	cfg.Provider = "pdns"
+	cfg.PDNSTLSEnabled = true
+	assert.Error(t, ValidateConfig(cfg))
+
+	cfg.TLSCA = "/path/to/ca.crt"
+	assert.NoError(t, ValidateConfig(cfg))
+
+	cfg = newValidConfig(t)
+	cfg.Provider = "pdns"
+	assert.NoError(t, ValidateConfig(cfg))
+}
+
+func TestValidateRFC2136Config(t *testing.T) {
+	badConfigs := []*externaldns.Config{
+		{},
+		{
+			// only key name
+			RFC2136TSIGKeyName: "key",
+		},
+		{
+			// only secret
+			RFC2136TSIGSecret: "secret",
+		},
+	}
+
+	for _, cfg := range badConfigs {
+		cfg.LogFormat = "json"
+		cfg.Sources = []string{"ingress"}
+		cfg.Provider = "rfc2136"
+		cfg.HealthzPath = "/healthz"
+		assert.Error(t, ValidateConfig(cfg), "Configuration %+v should NOT have passed validation", cfg)
+	}
+
+	goodConfigs := []*externaldns.Config{
+		{
+			RFC2136TSIGKeyName: "key",
+			RFC2136TSIGSecret:  "secret",
+		},
+		{
+			RFC2136Insecure: true,
+		},
+	}
+
+	for _, cfg := range goodConfigs {
+		cfg.LogFormat = "json"
+		cfg.Sources = []string{"ingress"}
+		cfg.Provider = "rfc2136"
+		cfg.HealthzPath = "/healthz"
+		assert.NoError(t, ValidateConfig(cfg), "Configuration %+v should have passed validation", cfg)
+	}
+}
@@ -132,6 +132,43 @@ func (suite *ResolverSuite) TestStrictResolver() {
 	suite.Equal(suite.bar127A, suite.perResource.ResolveUpdate(suite.legacyBar192A, []*endpoint.Endpoint{suite.bar127A, suite.bar192A}), " legacy record's resource value will not match, should pick minimum")
 }
 
+func (suite *ResolverSuite) TestStrictResolverMultipleTargets() {
+	fooA8 := &endpoint.Endpoint{
+		DNSName:    "foo",
+		Targets:    endpoint.Targets{"8.8.8.8", "1.1.1.1"},
+		RecordType: "A",
+		Labels: map[string]string{
+			endpoint.ResourceLabelKey: "ingress/default/foo-8",
+		},
+	}
+	fooA5 := &endpoint.Endpoint{
+		DNSName:    "foo",
+		Targets:    endpoint.Targets{"5.5.5.5", "2.2.2.2"},
+		RecordType: "A",
+		Labels: map[string]string{
+			endpoint.ResourceLabelKey: "ingress/default/foo-5",
+		},
+	}
+	fooA9 := &endpoint.Endpoint{
+		DNSName:    "foo",
+		Targets:    endpoint.Targets{"9.9.9.9"},
+		RecordType: "A",
+		Labels: map[string]string{
+			endpoint.ResourceLabelKey: "ingress/default/foo-9",
+		},
+	}
+
+	// targets are compared regardless of the order they were specified in, and shorter lists are less
+	suite.Equal(fooA8, suite.perResource.ResolveCreate([]*endpoint.Endpoint{fooA5, fooA8}), "should pick min one by sorted targets")
+	suite.Equal(fooA8, suite.perResource.ResolveCreate([]*endpoint.Endpoint{fooA8, fooA5}), "should pick min one by sorted targets")
+	suite.Equal(fooA9, suite.perResource.ResolveCreate([]*endpoint.Endpoint{fooA5, fooA8, fooA9}), "should pick the shortest list of targets")
+	suite.Equal(fooA8, suite.perResource.ResolveUpdate(suite.legacyBar192A, []*endpoint.Endpoint{fooA5, fooA8}), "should pick min one by sorted targets")
+
+	// resolving conflicts doesn't reorder the targets of the candidates
+	suite.Equal(endpoint.Targets{"8.8.8.8", "1.1.1.1"}, fooA8.Targets)
+	suite.Equal(endpoint.Targets{"5.5.5.5", "2.2.2.2"}, fooA5.Targets)
+}
+
 func TestConflictResolver(t *testing.T) {
 	suite.Run(t, new(ResolverSuite))
 }
@@ -502,6 +502,11 @@ func (p *AlibabaCloudProvider) unescapeTXTRecordValue(value string) string {
 	return value
 }
 
+// loggableTarget hides the value of TXT records in logs if endpoint.RedactTXT is set
+func loggableTarget(recordType, target string) string {
+	return endpoint.RedactTarget(recordType, target)
+}
+
 func (p *AlibabaCloudProvider) createRecord(endpoint *endpoint.Endpoint, target string) error {
 	rr, domain := p.splitDNSName(endpoint)
 	request := alidns.CreateAddDomainRecordRequest()
@@ -521,15 +526,15 @@ func (p *AlibabaCloudProvider) createRecord(endpoint *endpoint.Endpoint, target
 	request.Value = target
 
 	if p.dryRun {
-		log.Infof("Dry run: Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud DNS", endpoint.RecordType, endpoint.DNSName, target, ttl)
+		log.Infof("Dry run: Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud DNS", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), ttl)
 		return nil
 	}
 
 	response, err := p.getDNSClient().AddDomainRecord(request)
 	if err == nil {
-		log.Infof("Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud DNS: Record ID=%s", endpoint.RecordType, endpoint.DNSName, target, ttl, response.RecordId)
+		log.Infof("Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud DNS: Record ID=%s", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), ttl, response.RecordId)
 	} else {
-		log.Errorf("Failed to create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud DNS: %v", endpoint.RecordType, endpoint.DNSName, target, ttl, err)
+		log.Errorf("Failed to create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud DNS: %v", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), ttl, err)
 	}
 	return err
 }
@@ -879,7 +884,7 @@ func (p *AlibabaCloudProvider) createPrivateZoneRecord(zones map[string]*alibaba
 	zone := zones[domain]
 	if zone == nil {
 		err := fmt.Errorf("Failed to find private zone '%s'", domain)
-		log.Errorf("Failed to create %s record named '%s' to '%s' for Alibaba Cloud Private Zone: %v", endpoint.RecordType, endpoint.DNSName, target, err)
+		log.Errorf("Failed to create %s record named '%s' to '%s' for Alibaba Cloud Private Zone: %v", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), err)
 		return err
 	}
 
@@ -901,15 +906,15 @@ func (p *AlibabaCloudProvider) createPrivateZoneRecord(zones map[string]*alibaba
 	request.Value = target
 
 	if p.dryRun {
-		log.Infof("Dry run: Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud Private Zone", endpoint.RecordType, endpoint.DNSName, target, ttl)
+		log.Infof("Dry run: Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud Private Zone", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), ttl)
 		return nil
 	}
 
 	response, err := p.getPvtzClient().AddZoneRecord(request)
 	if err == nil {
-		log.Infof("Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud Private Zone: Record ID=%d", endpoint.RecordType, endpoint.DNSName, target, ttl, response.RecordId)
+		log.Infof("Create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud Private Zone: Record ID=%d", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), ttl, response.RecordId)
 	} else {
-		log.Errorf("Failed to create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud Private Zone: %v", endpoint.RecordType, endpoint.DNSName, target, ttl, err)
+		log.Errorf("Failed to create %s record named '%s' to '%s' with ttl %d for Alibaba Cloud Private Zone: %v", endpoint.RecordType, endpoint.DNSName, loggableTarget(endpoint.RecordType, target), ttl, err)
 	}
 	return err
 }
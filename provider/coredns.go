@@ -359,7 +359,7 @@ func (p coreDNSProvider) ApplyChanges(changes *plan.Changes) error {
 		}
 
 		for _, service := range services {
-			log.Infof("Add/set key %s to Host=%s, Text=%s, TTL=%d", service.Key, service.Host, service.Text, service.TTL)
+			log.Infof("Add/set key %s to Host=%s, Text=%s, TTL=%d", service.Key, service.Host, endpoint.RedactTarget(endpoint.RecordTypeTXT, service.Text), service.TTL)
 			if !p.dryRun {
 				err := p.client.SaveService(&service)
 				if err != nil {
@@ -433,7 +433,7 @@ func (p designateProvider) upsertRecordSet(rs *recordSet, managedZones map[strin
 			Type:    rs.recordType,
 			Records: records,
 		}
-		log.Infof("Creating records: %s/%s: %s", rs.dnsName, rs.recordType, strings.Join(records, ","))
+		log.Infof("Creating records: %s/%s: %s", rs.dnsName, rs.recordType, strings.Join(endpoint.RedactTargets(rs.recordType, records), ","))
 		if p.dryRun {
 			return nil
 		}
@@ -449,7 +449,7 @@ func (p designateProvider) upsertRecordSet(rs *recordSet, managedZones map[strin
 		opts := recordsets.UpdateOpts{
 			Records: records,
 		}
-		log.Infof("Updating records: %s/%s: %s", rs.dnsName, rs.recordType, strings.Join(records, ","))
+		log.Infof("Updating records: %s/%s: %s", rs.dnsName, rs.recordType, strings.Join(endpoint.RedactTargets(rs.recordType, records), ","))
 		if p.dryRun {
 			return nil
 		}
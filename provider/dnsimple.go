@@ -232,7 +232,9 @@ func (p *dnsimpleProvider) submitChanges(changes []*dnsimpleChange) error {
 			continue
 		}
 
-		log.Infof("Changing records: %s %v in zone: %s", change.Action, change.ResourceRecordSet, zone.Name)
+		logged := change.ResourceRecordSet
+		logged.Content = endpoint.RedactTarget(logged.Type, logged.Content)
+		log.Infof("Changing records: %s %v in zone: %s", change.Action, logged, zone.Name)
 
 		change.ResourceRecordSet.Name = strings.TrimSuffix(change.ResourceRecordSet.Name, "."+zone.Name)
 		if !p.dryRun {
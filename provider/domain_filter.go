@@ -20,36 +20,56 @@ import (
 	"strings"
 )
 
-// DomainFilter holds a lists of valid domain names
+// DomainFilter holds a lists of valid domain names and a list of domain names which are excluded from them
 type DomainFilter struct {
 	filters []string
+	exclude []string
 }
 
-// NewDomainFilter returns a new DomainFilter given a comma separated list of domains
-func NewDomainFilter(domainFilters []string) DomainFilter {
-	filters := make([]string, len(domainFilters))
+// prepareFilters provides consistent trimming for filters/exclude params
+func prepareFilters(filters []string) []string {
+	fs := make([]string, len(filters))
 
 	// user can define filter domains either with trailing dot or without, we remove all trailing periods from
 	// the internal representation
-	for i, domain := range domainFilters {
-		filters[i] = strings.TrimSuffix(strings.TrimSpace(domain), ".")
+	for i, domain := range filters {
+		fs[i] = strings.TrimSuffix(strings.TrimSpace(domain), ".")
 	}
 
-	return DomainFilter{filters}
+	return fs
+}
+
+// NewDomainFilterWithExclusions returns a new DomainFilter given a list of domains to include and a list of
+// domains to exclude from them
+func NewDomainFilterWithExclusions(domainFilters []string, excludeDomains []string) DomainFilter {
+	return DomainFilter{prepareFilters(domainFilters), prepareFilters(excludeDomains)}
+}
+
+// NewDomainFilter returns a new DomainFilter given a comma separated list of domains
+func NewDomainFilter(domainFilters []string) DomainFilter {
+	return NewDomainFilterWithExclusions(domainFilters, []string{})
 }
 
-// Match checks whether a domain can be found in the DomainFilter.
+// Match checks whether a domain can be found in the DomainFilter and isn't excluded by it.
 func (df DomainFilter) Match(domain string) bool {
-	// return always true, if not filter is specified
-	if len(df.filters) == 0 {
-		return true
+	return matchFilter(df.filters, domain, true) && !matchFilter(df.exclude, domain, false)
+}
+
+// matchFilter checks whether a domain matches one of the given filters, returning emptyval if no filter is specified.
+// An empty include filter matches every domain while empty exclude entries are ignored.
+func matchFilter(filters []string, domain string, emptyval bool) bool {
+	if len(filters) == 0 {
+		return emptyval
 	}
 
-	for _, filter := range df.filters {
+	for _, filter := range filters {
 		strippedDomain := strings.TrimSuffix(domain, ".")
 
 		if filter == "" {
-			return true
+			if emptyval {
+				return true
+			}
+			continue
 		} else if strings.HasPrefix(filter, ".") && strings.HasSuffix(strippedDomain, filter) {
 			return true
 		} else if strings.Count(strippedDomain, ".") == strings.Count(filter, ".") {
@@ -66,6 +86,11 @@ func (df DomainFilter) Match(domain string) bool {
 
 // IsConfigured returns true if DomainFilter is configured, false otherwise
 func (df DomainFilter) IsConfigured() bool {
+	for _, exclude := range df.exclude {
+		if exclude != "" {
+			return true
+		}
+	}
 	if len(df.filters) == 1 {
 		return df.filters[0] != ""
 	}
@@ -24,6 +24,7 @@ import (
 
 type domainFilterTest struct {
 	domainFilter []string
+	exclusions   []string
 	domains      []string
 	expected     bool
 }
@@ -31,109 +32,189 @@ type domainFilterTest struct {
 var domainFilterTests = []domainFilterTest{
 	{
 		[]string{"google.com.", "exaring.de", "inovex.de"},
+		[]string{},
 		[]string{"google.com", "exaring.de", "inovex.de"},
 		true,
 	},
 	{
 		[]string{"google.com.", "exaring.de", "inovex.de"},
+		[]string{},
 		[]string{"google.com", "exaring.de", "inovex.de"},
 		true,
 	},
 	{
 		[]string{"google.com.", "exaring.de.", "inovex.de"},
+		[]string{},
 		[]string{"google.com", "exaring.de", "inovex.de"},
 		true,
 	},
 	{
 		[]string{"foo.org.      "},
+		[]string{},
 		[]string{"foo.org"},
 		true,
 	},
 	{
 		[]string{"   foo.org"},
+		[]string{},
 		[]string{"foo.org"},
 		true,
 	},
 	{
 		[]string{"foo.org."},
+		[]string{},
 		[]string{"foo.org"},
 		true,
 	},
 	{
 		[]string{"foo.org."},
+		[]string{},
 		[]string{"baz.org"},
 		false,
 	},
 	{
 		[]string{"baz.foo.org."},
+		[]string{},
 		[]string{"foo.org"},
 		false,
 	},
 	{
 		[]string{"", "foo.org."},
+		[]string{},
 		[]string{"foo.org"},
 		true,
 	},
 	{
 		[]string{"", "foo.org."},
 		[]string{},
+		[]string{},
 		true,
 	},
 	{
 		[]string{""},
+		[]string{},
 		[]string{"foo.org"},
 		true,
 	},
 	{
 		[]string{""},
 		[]string{},
+		[]string{},
 		true,
 	},
 	{
 		[]string{" "},
 		[]string{},
+		[]string{},
 		true,
 	},
 	{
 		[]string{"bar.sub.example.org"},
+		[]string{},
 		[]string{"foo.bar.sub.example.org"},
 		true,
 	},
 	{
 		[]string{"example.org"},
+		[]string{},
 		[]string{"anexample.org", "test.anexample.org"},
 		false,
 	},
 	{
 		[]string{".example.org"},
+		[]string{},
 		[]string{"anexample.org", "test.anexample.org"},
 		false,
 	},
 	{
 		[]string{".example.org"},
+		[]string{},
 		[]string{"example.org"},
 		false,
 	},
 	{
 		[]string{".example.org"},
+		[]string{},
 		[]string{"test.example.org"},
 		true,
 	},
 	{
 		[]string{"anexample.org"},
+		[]string{},
 		[]string{"example.org", "test.example.org"},
 		false,
 	},
 	{
 		[]string{".org"},
+		[]string{},
 		[]string{"example.org", "test.example.org", "foo.test.example.org"},
 		true,
 	},
+	{
+		[]string{"example.org"},
+		[]string{"api.example.org"},
+		[]string{"example.org", "foo.example.org"},
+		true,
+	},
+	{
+		[]string{"example.org"},
+		[]string{"api.example.org"},
+		[]string{"api.example.org", "foo.api.example.org"},
+		false,
+	},
+	{
+		[]string{"example.org"},
+		[]string{".api.example.org"},
+		[]string{"api.example.org"},
+		true,
+	},
+	{
+		[]string{""},
+		[]string{"example.org"},
+		[]string{"example.org", "foo.example.org"},
+		false,
+	},
+	{
+		[]string{""},
+		[]string{"example.org"},
+		[]string{"foo.org", "anexample.org"},
+		true,
+	},
+	{
+		[]string{"example.org"},
+		[]string{""},
+		[]string{"foo.example.org"},
+		true,
+	},
+	{
+		[]string{"example.org."},
+		[]string{"api.example.org.   "},
+		[]string{"api.example.org"},
+		false,
+	},
+	{
+		[]string{"example.org"},
+		[]string{"", "api.example.org"},
+		[]string{"api.example.org", "foo.api.example.org"},
+		false,
+	},
+	{
+		[]string{"example.org"},
+		[]string{""},
+		[]string{"foo.example.org", "api.example.org"},
+		true,
+	},
+	{
+		[]string{""},
+		[]string{"api.example.org"},
+		[]string{"api.example.org"},
+		false,
+	},
 }
 
 func TestDomainFilterMatch(t *testing.T) {
 	for i, tt := range domainFilterTests {
-		domainFilter := NewDomainFilter(tt.domainFilter)
+		domainFilter := NewDomainFilterWithExclusions(tt.domainFilter, tt.exclusions)
 		for _, domain := range tt.domains {
 			assert.Equal(t, tt.expected, domainFilter.Match(domain), "should not fail: %v in test-case #%v", domain, i)
 			assert.Equal(t, tt.expected, domainFilter.Match(domain+"."), "should not fail: %v in test-case #%v", domain+".", i)
@@ -141,6 +222,18 @@ func TestDomainFilterMatch(t *testing.T) {
 	}
 }
 
+func TestDomainFilterWithoutExclusionsMatch(t *testing.T) {
+	for i, tt := range domainFilterTests {
+		if len(tt.exclusions) > 0 {
+			continue
+		}
+		domainFilter := NewDomainFilter(tt.domainFilter)
+		for _, domain := range tt.domains {
+			assert.Equal(t, tt.expected, domainFilter.Match(domain), "should not fail: %v in test-case #%v", domain, i)
+		}
+	}
+}
+
 func TestDomainFilterMatchWithEmptyFilter(t *testing.T) {
 	for _, tt := range domainFilterTests {
 		domainFilter := DomainFilter{}
@@ -150,3 +243,20 @@ func TestDomainFilterMatchWithEmptyFilter(t *testing.T) {
 		}
 	}
 }
+
+func TestDomainFilterIsConfigured(t *testing.T) {
+	for i, tt := range []struct {
+		domainFilter []string
+		exclusions   []string
+		expected     bool
+	}{
+		{[]string{}, []string{}, false},
+		{[]string{""}, []string{""}, false},
+		{[]string{"example.org"}, []string{""}, true},
+		{[]string{""}, []string{"api.example.org"}, true},
+		{[]string{}, []string{"", "api.example.org"}, true},
+	} {
+		domainFilter := NewDomainFilterWithExclusions(tt.domainFilter, tt.exclusions)
+		assert.Equal(t, tt.expected, domainFilter.IsConfigured(), "should not fail: test-case #%v", i)
+	}
+}
@@ -260,7 +260,7 @@ func (d *dynProviderState) allRecordsToEndpoints(records *dynectsoap.GetAllRecor
 			RecordType: rec.Record_type,
 			Targets:    endpoint.Targets{rec.Rdata.Address},
 		}
-		log.Debugf("A record: %v", *ep)
+		log.Debugf("A record: %s", ep)
 		result = append(result, ep)
 	}
 
@@ -272,7 +272,7 @@ func (d *dynProviderState) allRecordsToEndpoints(records *dynectsoap.GetAllRecor
 			RecordType: rec.Record_type,
 			Targets:    endpoint.Targets{strings.TrimSuffix(rec.Rdata.Cname, ".")},
 		}
-		log.Debugf("CNAME record: %v", *ep)
+		log.Debugf("CNAME record: %s", ep)
 		result = append(result, ep)
 	}
 
@@ -284,7 +284,7 @@ func (d *dynProviderState) allRecordsToEndpoints(records *dynectsoap.GetAllRecor
 			RecordType: rec.Record_type,
 			Targets:    endpoint.Targets{rec.Rdata.Txtdata},
 		}
-		log.Debugf("TXT record: %v", *ep)
+		log.Debugf("TXT record: %s", ep)
 		result = append(result, ep)
 	}
 
@@ -300,6 +300,12 @@ func errorOrValue(err error, value interface{}) interface{} {
 	return err
 }
 
+// redactRecordResponse hides TXT data echoed back by the API, so that the response can be logged
+func redactRecordResponse(response *dynect.RecordResponse) *dynect.RecordResponse {
+	response.Data.RData.TxtData = endpoint.RedactTarget(endpoint.RecordTypeTXT, response.Data.RData.TxtData)
+	return response
+}
+
 // endpointToRecord puts the Target of an Endpoint in the correct field of DataBlock.
 // See DataBlock comments for more info
 func endpointToRecord(ep *endpoint.Endpoint) *dynect.DataBlock {
@@ -501,7 +507,7 @@ func (d *dynProviderState) deleteRecord(client *dynect.Client, ep *endpoint.Endp
 		return client.Do("DELETE", link, nil, &response)
 	})
 
-	log.Debugf("Deleting record %s: %+v,", link, errorOrValue(err, &response))
+	log.Debugf("Deleting record %s: %+v,", link, errorOrValue(err, redactRecordResponse(&response)))
 	return err
 }
 
@@ -517,7 +523,7 @@ func (d *dynProviderState) replaceRecord(client *dynect.Client, ep *endpoint.End
 		return client.Do("PUT", link, record, &response)
 	})
 
-	log.Debugf("Replacing record %s: %+v,", link, errorOrValue(err, &response))
+	log.Debugf("Replacing record %s: %+v,", link, errorOrValue(err, redactRecordResponse(&response)))
 	return err
 }
 
@@ -533,7 +539,7 @@ func (d *dynProviderState) createRecord(client *dynect.Client, ep *endpoint.Endp
 		return client.Do("POST", link, record, &response)
 	})
 
-	log.Debugf("Creating record %s: %+v,", link, errorOrValue(err, &response))
+	log.Debugf("Creating record %s: %+v,", link, errorOrValue(err, redactRecordResponse(&response)))
 	return err
 }
 
@@ -546,6 +552,9 @@ func (d *dynProviderState) commit(client *dynect.Client) error {
 		if log.GetLevel() >= log.DebugLevel {
 			response := ZoneChangesResponse{}
 			err := client.Do("GET", fmt.Sprintf("ZoneChanges/%s/", zone), nil, &response)
+			for i := range response.Data {
+				response.Data[i].RData.TxtData = endpoint.RedactTarget(endpoint.RecordTypeTXT, response.Data[i].RData.TxtData)
+			}
 			log.Debugf("Pending changes for zone %s: %+v", zone, errorOrValue(err, &response))
 		}
 
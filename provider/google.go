@@ -171,14 +171,14 @@ func (p *GoogleProvider) Zones() (map[string]*dns.ManagedZone, error) {
 		return nil
 	}
 
-	log.Debugf("Matching zones against domain filters: %v", p.domainFilter.filters)
+	log.Debugf("Matching zones against domain filters: %v, excluding: %v", p.domainFilter.filters, p.domainFilter.exclude)
 	if err := p.managedZonesClient.List(p.project).Pages(context.TODO(), f); err != nil {
 		return nil, err
 	}
 
 	if len(zones) == 0 {
 		if p.domainFilter.IsConfigured() {
-			log.Warnf("No zones in the project, %s, match domain filters: %v", p.project, p.domainFilter.filters)
+			log.Warnf("No zones in the project, %s, match domain filters: %v, excluding: %v", p.project, p.domainFilter.filters, p.domainFilter.exclude)
 		} else {
 			log.Warnf("No zones found in the project, %s", p.project)
 		}
@@ -291,10 +291,10 @@ func (p *GoogleProvider) submitChange(change *dns.Change) error {
 	for z, c := range changes {
 		log.Infof("Change zone: %v", z)
 		for _, del := range c.Deletions {
-			log.Infof("Del records: %s %s %s %d", del.Name, del.Type, del.Rrdatas, del.Ttl)
+			log.Infof("Del records: %s %s %s %d", del.Name, del.Type, endpoint.RedactTargets(del.Type, del.Rrdatas), del.Ttl)
 		}
 		for _, add := range c.Additions {
-			log.Infof("Add records: %s %s %s %d", add.Name, add.Type, add.Rrdatas, add.Ttl)
+			log.Infof("Add records: %s %s %s %d", add.Name, add.Type, endpoint.RedactTargets(add.Type, add.Rrdatas), add.Ttl)
 		}
 	}
 
@@ -326,7 +326,7 @@ func separateChange(zones map[string]*dns.ManagedZone, change *dns.Change) map[s
 		if zoneName, _ := zoneNameIDMapper.FindZone(ensureTrailingDot(a.Name)); zoneName != "" {
 			changes[zoneName].Additions = append(changes[zoneName].Additions, a)
 		} else {
-			log.Warnf("No matching zone for record addition: %s %s %s %d", a.Name, a.Type, a.Rrdatas, a.Ttl)
+			log.Warnf("No matching zone for record addition: %s %s %s %d", a.Name, a.Type, endpoint.RedactTargets(a.Type, a.Rrdatas), a.Ttl)
 		}
 	}
 
@@ -334,7 +334,7 @@ func separateChange(zones map[string]*dns.ManagedZone, change *dns.Change) map[s
 		if zoneName, _ := zoneNameIDMapper.FindZone(ensureTrailingDot(d.Name)); zoneName != "" {
 			changes[zoneName].Deletions = append(changes[zoneName].Deletions, d)
 		} else {
-			log.Warnf("No matching zone for record deletion: %s %s %s %d", d.Name, d.Type, d.Rrdatas, d.Ttl)
+			log.Warnf("No matching zone for record deletion: %s %s %s %d", d.Name, d.Type, endpoint.RedactTargets(d.Type, d.Rrdatas), d.Ttl)
 		}
 	}
 
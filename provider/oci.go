@@ -109,7 +109,7 @@ func NewOCIProvider(cfg OCIConfig, domainFilter DomainFilter, zoneIDFilter ZoneI
 func (p *OCIProvider) zones(ctx context.Context) (map[string]*dns.ZoneSummary, error) {
 	zones := make(map[string]*dns.ZoneSummary)
 
-	log.Debugf("Matching zones against domain filters: %v", p.domainFilter.filters)
+	log.Debugf("Matching zones against domain filters: %v, excluding: %v", p.domainFilter.filters, p.domainFilter.exclude)
 	var page *string
 	for {
 		resp, err := p.client.ListZones(ctx, dns.ListZonesRequest{
@@ -137,7 +137,7 @@ func (p *OCIProvider) zones(ctx context.Context) (map[string]*dns.ZoneSummary, e
 
 	if len(zones) == 0 {
 		if p.domainFilter.IsConfigured() {
-			log.Warnf("No zones in compartment %q match domain filters %v", p.cfg.CompartmentID, p.domainFilter.filters)
+			log.Warnf("No zones in compartment %q match domain filters %v, excluding: %v", p.cfg.CompartmentID, p.domainFilter.filters, p.domainFilter.exclude)
 		} else {
 			log.Warnf("No zones found in compartment %q", p.cfg.CompartmentID)
 		}
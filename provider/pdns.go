@@ -275,6 +275,26 @@ func (p *PDNSProvider) convertRRSetToEndpoints(rr pgo.RrSet) (endpoints []*endpo
 	return endpoints, nil
 }
 
+// redactZones returns a copy of the zones for logging, hiding the content of TXT records if endpoint.RedactTXT is set
+func redactZones(zones []pgo.Zone) []pgo.Zone {
+	if !endpoint.RedactTXT {
+		return zones
+	}
+	redacted := make([]pgo.Zone, len(zones))
+	for i, zone := range zones {
+		zone.Rrsets = append([]pgo.RrSet(nil), zone.Rrsets...)
+		for j, rrset := range zone.Rrsets {
+			rrset.Records = append([]pgo.Record(nil), rrset.Records...)
+			for k := range rrset.Records {
+				rrset.Records[k].Content = endpoint.RedactTarget(rrset.Type_, rrset.Records[k].Content)
+			}
+			zone.Rrsets[j] = rrset
+		}
+		redacted[i] = zone
+	}
+	return redacted
+}
+
 // ConvertEndpointsToZones marshals endpoints into pdns compatible Zone structs
 func (p *PDNSProvider) ConvertEndpointsToZones(eps []*endpoint.Endpoint, changetype pdnsChangeType) (zonelist []pgo.Zone, _ error) {
 
@@ -383,7 +403,7 @@ func (p *PDNSProvider) ConvertEndpointsToZones(eps []*endpoint.Endpoint, changet
 		log.Warnf("No matching zones were found for the following endpoints: %+v", endpoints)
 	}
 
-	log.Debugf("Zone List generated from Endpoints: %+v", zonelist)
+	log.Debugf("Zone List generated from Endpoints: %+v", redactZones(zonelist))
 
 	return zonelist, nil
 }
@@ -495,6 +495,11 @@ var (
 		filters: []string{},
 	}
 
+	DomainFilterListExcludeOnly = DomainFilter{
+		filters: []string{""},
+		exclude: []string{"mock.test"},
+	}
+
 	DomainFilterEmptyClient = &PDNSAPIClient{
 		dryRun:       false,
 		authCtx:      context.WithValue(context.TODO(), pgo.ContextAPIKey, pgo.APIKey{Key: "TEST-API-KEY"}),
@@ -515,6 +520,13 @@ var (
 		client:       pgo.NewAPIClient(pgo.NewConfiguration()),
 		domainFilter: DomainFilterListMultiple,
 	}
+
+	DomainFilterExcludeOnlyClient = &PDNSAPIClient{
+		dryRun:       false,
+		authCtx:      context.WithValue(context.TODO(), pgo.ContextAPIKey, pgo.APIKey{Key: "TEST-API-KEY"}),
+		client:       pgo.NewAPIClient(pgo.NewConfiguration()),
+		domainFilter: DomainFilterListExcludeOnly,
+	}
 )
 
 /******************************************************************************/
@@ -981,6 +993,14 @@ func (suite *NewPDNSProviderTestSuite) TestPDNSClientPartitionZones() {
 		ZoneEmpty2,
 	}
 
+	partitionResultFilteredExcludeOnlyFilter := []pgo.Zone{
+		ZoneEmpty,
+	}
+
+	partitionResultResidualExcludeOnlyFilter := []pgo.Zone{
+		ZoneEmpty2,
+	}
+
 	// Check filtered, residual zones when no domain filter specified
 	filteredZones, residualZones := DomainFilterEmptyClient.PartitionZones(zoneList)
 	assert.Equal(suite.T(), partitionResultFilteredEmptyFilter, filteredZones)
@@ -995,6 +1015,11 @@ func (suite *NewPDNSProviderTestSuite) TestPDNSClientPartitionZones() {
 	filteredZones, residualZones = DomainFilterMultipleClient.PartitionZones(zoneList)
 	assert.Equal(suite.T(), partitionResultFilteredMultipleFilter, filteredZones)
 	assert.Equal(suite.T(), partitionResultResidualMultipleFilter, residualZones)
+
+	// Check filtered, residual zones when only excluded domains are specified
+	filteredZones, residualZones = DomainFilterExcludeOnlyClient.PartitionZones(zoneList)
+	assert.Equal(suite.T(), partitionResultFilteredExcludeOnlyFilter, filteredZones)
+	assert.Equal(suite.T(), partitionResultResidualExcludeOnlyFilter, residualZones)
 }
 
 func TestNewPDNSProviderTestSuite(t *testing.T) {
@@ -103,7 +103,7 @@ func (r rfc2136Provider) Records() ([]*endpoint.Endpoint, error) {
 
 OuterLoop:
 	for _, rr := range rrs {
-		log.Debugf("Record=%s", rr)
+		log.Debugf("Record=%s", loggableRR(rr))
 
 		if rr.Header().Class != dns.ClassINET {
 			continue
@@ -238,11 +238,19 @@ func (r rfc2136Provider) UpdateRecord(ep *endpoint.Endpoint) error {
 	return r.AddRecord(ep)
 }
 
+// loggableRR formats rr for logging, hiding the value of TXT records if endpoint.RedactTXT is set
+func loggableRR(rr dns.RR) string {
+	if txt, ok := rr.(*dns.TXT); ok && endpoint.RedactTXT {
+		return txt.Hdr.String() + endpoint.RedactedTarget
+	}
+	return rr.String()
+}
+
 func (r rfc2136Provider) AddRecord(ep *endpoint.Endpoint) error {
 	log.Debugf("AddRecord.ep=%s", ep)
 	for _, target := range ep.Targets {
 		newRR := fmt.Sprintf("%s %d %s %s", ep.DNSName, ep.RecordTTL, ep.RecordType, target)
-		log.Debugf("Adding RR: %s", newRR)
+		log.Debugf("Adding RR: %s %d %s %s", ep.DNSName, ep.RecordTTL, ep.RecordType, endpoint.RedactTarget(ep.RecordType, target))
 
 		rr, err := dns.NewRR(newRR)
 		if err != nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package provider
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -117,6 +118,36 @@ func TestRfc2136GetRecords(t *testing.T) {
 	assert.True(t, contains(recs, "v2.foo.com"))
 }
 
+func TestRfc2136RedactTXT(t *testing.T) {
+	var buf bytes.Buffer
+	out, level := log.StandardLogger().Out, log.GetLevel()
+	log.SetOutput(&buf)
+	log.SetLevel(log.DebugLevel)
+	endpoint.RedactTXT = true
+	defer func() {
+		log.SetOutput(out)
+		log.SetLevel(level)
+		endpoint.RedactTXT = false
+	}()
+
+	stub := newStub()
+	err := stub.setOutput([]string{
+		"_acme-challenge.foo.com 3600 TXT secret-token",
+	})
+	assert.NoError(t, err)
+
+	provider, err := createRfc2136StubProvider(stub)
+	assert.NoError(t, err)
+
+	recs, err := provider.Records()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(recs))
+
+	logs := buf.String()
+	assert.Contains(t, logs, "_acme-challenge.foo.com")
+	assert.NotContains(t, logs, "secret-token")
+}
+
 func TestRfc2136ApplyChanges(t *testing.T) {
 	stub := newStub()
 	provider, err := createRfc2136StubProvider(stub)
@@ -17,8 +17,12 @@ limitations under the License.
 package source
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/kubernetes-incubator/external-dns/endpoint"
 	"github.com/kubernetes-incubator/external-dns/internal/testutils"
 )
@@ -28,6 +32,7 @@ var _ Source = &dedupSource{}
 
 func TestDedup(t *testing.T) {
 	t.Run("Endpoints", testDedupEndpoints)
+	t.Run("RedactTXT", testDedupRedactTXT)
 }
 
 // testDedupEndpoints tests that duplicates from the wrapped source are removed.
@@ -110,3 +115,35 @@ func testDedupEndpoints(t *testing.T) {
 		})
 	}
 }
+
+// testDedupRedactTXT tests that the values of removed TXT duplicates are not logged under redaction.
+func testDedupRedactTXT(t *testing.T) {
+	var buf bytes.Buffer
+	out, level := log.StandardLogger().Out, log.GetLevel()
+	log.SetOutput(&buf)
+	log.SetLevel(log.DebugLevel)
+	endpoint.RedactTXT = true
+	defer func() {
+		log.SetOutput(out)
+		log.SetLevel(level)
+		endpoint.RedactTXT = false
+	}()
+
+	mockSource := new(testutils.MockSource)
+	mockSource.On("Endpoints").Return([]*endpoint.Endpoint{
+		{DNSName: "_acme-challenge.example.org", RecordType: endpoint.RecordTypeTXT, Targets: endpoint.Targets{"secret-token"}},
+		{DNSName: "_acme-challenge.example.org", RecordType: endpoint.RecordTypeTXT, Targets: endpoint.Targets{"secret-token"}},
+	}, nil)
+
+	if _, err := NewDedupSource(mockSource).Endpoints(); err != nil {
+		t.Fatal(err)
+	}
+
+	logs := buf.String()
+	if !strings.Contains(logs, "_acme-challenge.example.org") {
+		t.Errorf("expected the record name to be logged, got: %s", logs)
+	}
+	if strings.Contains(logs, "secret-token") {
+		t.Errorf("expected the TXT value to be redacted, got: %s", logs)
+	}
+}